@@ -0,0 +1,123 @@
+package events
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestAsyncEmitDoesNotBlockCaller confirms a slow listener only stalls its
+// own worker, not the goroutine calling Emit.
+func TestAsyncEmitDoesNotBlockCaller(t *testing.T) {
+	e := NewAsync(1, 1)
+
+	block := make(chan struct{})
+	e.On("slow", func(data ...interface{}) {
+		<-block
+	})
+
+	done := make(chan struct{})
+	go func() {
+		e.Emit("slow")
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Emit blocked on a slow listener instead of returning immediately")
+	}
+
+	close(block)
+	e.(AsyncEventEmitter).Drain()
+}
+
+// TestCloseDrainsQueuedEmits confirms Close waits for already-queued emits
+// to be dispatched before it returns, rather than abandoning them.
+func TestCloseDrainsQueuedEmits(t *testing.T) {
+	e := NewAsync(8, 2)
+
+	var count uint64
+	e.On("evt", func(data ...interface{}) {
+		atomic.AddUint64(&count, 1)
+	})
+
+	const n = 8
+	for i := 0; i < n; i++ {
+		e.Emit("evt")
+	}
+
+	if err := e.(AsyncEventEmitter).Close(); err != nil {
+		t.Fatalf("Close() = %v, want nil", err)
+	}
+
+	if got := atomic.LoadUint64(&count); got != n {
+		t.Fatalf("dispatched %d of %d queued emits before Close returned", got, n)
+	}
+}
+
+// TestDrainBlocksUntilPendingWorkCompletes confirms Drain doesn't return
+// early while an emit is still in flight.
+func TestDrainBlocksUntilPendingWorkCompletes(t *testing.T) {
+	e := NewAsync(1, 1)
+
+	var finished int32
+	release := make(chan struct{})
+	e.On("evt", func(data ...interface{}) {
+		<-release
+		atomic.StoreInt32(&finished, 1)
+	})
+
+	e.Emit("evt")
+
+	drained := make(chan struct{})
+	go func() {
+		e.(AsyncEventEmitter).Drain()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		t.Fatal("Drain returned before the in-flight listener finished")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+
+	select {
+	case <-drained:
+	case <-time.After(time.Second):
+		t.Fatal("Drain never returned after the listener finished")
+	}
+
+	if atomic.LoadInt32(&finished) != 1 {
+		t.Fatal("Drain returned before the listener actually ran")
+	}
+}
+
+// TestEmitDuringCloseNeverPanics guards against a regression where a
+// concurrent Close could close eventCh between emit's closed-check and its
+// send onto eventCh, turning an ordinary Emit call into a "send on closed
+// channel" panic.
+func TestEmitDuringCloseNeverPanics(t *testing.T) {
+	e := NewAsync(1, 2)
+	e.On("evt", func(data ...interface{}) {})
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 500; i++ {
+			e.Emit("evt")
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		e.(AsyncEventEmitter).Close()
+	}()
+
+	wg.Wait()
+}
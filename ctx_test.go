@@ -0,0 +1,56 @@
+package events
+
+import (
+	"context"
+	"testing"
+)
+
+// TestEmitCtxDeliversContext confirms a ctx-aware listener receives the
+// exact context passed to EmitCtx.
+func TestEmitCtxDeliversContext(t *testing.T) {
+	e := New().(ContextEventEmitter)
+
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, "value")
+
+	var got interface{}
+	e.OnCtx("evt", func(ctx context.Context, data ...interface{}) {
+		got = ctx.Value(key{})
+	})
+
+	e.EmitCtx(ctx, "evt")
+
+	if got != "value" {
+		t.Fatalf("listener saw ctx value %v, want %q", got, "value")
+	}
+}
+
+// TestEmitCtxCancelledSkipsWholeInvocation confirms that when ctx is
+// already cancelled by the time dispatch runs, no listener for the event
+// runs at all — not even a plain listener registered via On/AddListener —
+// matching the request's "skip queued invocations whose context is
+// already cancelled" wording, rather than only skipping ctx-aware
+// listeners.
+func TestEmitCtxCancelledSkipsWholeInvocation(t *testing.T) {
+	e := New().(ContextEventEmitter)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var plainFired, ctxFired bool
+	e.On("evt", func(data ...interface{}) {
+		plainFired = true
+	})
+	e.OnCtx("evt", func(ctx context.Context, data ...interface{}) {
+		ctxFired = true
+	})
+
+	e.EmitCtx(ctx, "evt")
+
+	if plainFired {
+		t.Fatal("plain listener fired for an already-cancelled ctx")
+	}
+	if ctxFired {
+		t.Fatal("ctx-aware listener fired for an already-cancelled ctx")
+	}
+}
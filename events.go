@@ -1,15 +1,23 @@
 package events
 
 import (
+	"context"
 	"log"
 	"reflect"
+	"strings"
 	"sync"
+	"sync/atomic"
 )
 
 const (
 	Version             = "0.0.1"
 	DefaultMaxListeners = 0
 	EnableWarning       = false
+
+	// ErrorEvent is the reserved event name a recovered listener panic is
+	// re-emitted on when no ErrorHandler is set, following the Node.js
+	// EventEmitter convention of a special "error" event.
+	ErrorEvent EventName = "error"
 )
 
 type (
@@ -31,22 +39,193 @@ type (
 		Clear()
 		SetMaxListeners(int)
 		Len() int
+		// PatternNames returns the wildcard patterns (containing "*" or
+		// "**" segments) currently subscribed via On, as opposed to
+		// EventNames, which only ever reports concrete names.
+		PatternNames() []EventName
+	}
+
+	// Fireable is implemented by emitters that can bypass their normal
+	// dispatch mode to call listeners synchronously on the calling
+	// goroutine. It is primarily an escape hatch for async emitters.
+	Fireable interface {
+		EmitSync(EventName, ...interface{})
+	}
+
+	// AsyncEventEmitter is an EventEmitter that queues emits onto a
+	// buffered channel and dispatches them from a fixed pool of worker
+	// goroutines, so a slow listener only stalls its own worker instead of
+	// the emitting goroutine or every other listener.
+	AsyncEventEmitter interface {
+		EventEmitter
+		Fireable
+		// Close stops accepting new emits, waits for queued ones to
+		// drain, and shuts down the worker pool.
+		Close() error
+		// Drain blocks until every emit queued so far has been
+		// dispatched, without shutting the emitter down.
+		Drain()
+	}
+
+	// ListenerCtx is a Listener that additionally receives the context
+	// passed to EmitCtx, so it can honor deadlines/cancellation from
+	// request-scoped callers.
+	ListenerCtx func(context.Context, ...interface{})
+
+	// ContextEventEmitter is an EventEmitter that also accepts
+	// context-aware listeners and a cancellable Emit.
+	ContextEventEmitter interface {
+		EventEmitter
+		OnCtx(EventName, ListenerCtx)
+		EmitCtx(context.Context, EventName, ...interface{})
+	}
+
+	// ErrorHandler receives a listener's recovered panic value together
+	// with the EventName it panicked while handling. If none is set via
+	// SetErrorHandler, the panic is re-emitted on ErrorEvent instead.
+	ErrorHandler func(EventName, interface{})
+
+	// Stats reports cumulative dispatch counters for an emitter, analogous
+	// to the peak/current listener counters found in other event bus
+	// implementations.
+	Stats struct {
+		Dispatched       uint64 // listener calls that returned normally
+		Panicked         uint64 // listener calls recovered from a panic
+		DroppedAsyncFull uint64 // emits dropped because the async queue was full or was closed mid-send
+	}
+
+	// ObservableEventEmitter is an EventEmitter with panic-isolated
+	// listener dispatch and dispatch metrics.
+	ObservableEventEmitter interface {
+		EventEmitter
+		SetErrorHandler(ErrorHandler)
+		Stats() Stats
+	}
+
+	// listenerEntry pairs a registered Listener with an id that is unique for
+	// the lifetime of the emitter, so that internal bookkeeping (in
+	// particular Once's self-removal) never has to rely on comparing func
+	// pointers, which collide for distinct closures built from the same
+	// literal.
+	listenerEntry struct {
+		id uint64
+		fn Listener
+	}
+
+	// onceEntry remembers which listenerEntry a Once wrapper was registered
+	// under, keyed by the pointer of the original (user-supplied) Listener,
+	// so that RemoveListener(evt, original) still finds and removes the
+	// wrapper instead of the original function.
+	onceEntry struct {
+		original uintptr
+		id       uint64
+	}
+
+	// ctxListenerEntry pairs a registered ListenerCtx with an id, mirroring
+	// listenerEntry for the context-aware listener store.
+	ctxListenerEntry struct {
+		id uint64
+		fn ListenerCtx
 	}
 
+	// emitEvent is one queued emit awaiting dispatch by a worker in async
+	// mode.
+	emitEvent struct {
+		evt  EventName
+		ctx  context.Context
+		data []interface{}
+	}
+
+	// patternNode is one segment of the trie storing wildcard pattern
+	// subscriptions (e.g. "user.*", "**"), keyed by dot-separated name
+	// segment. A child keyed "*" matches exactly one segment; a child
+	// keyed "**" matches the rest of the name, however many segments
+	// remain (including zero).
+	patternNode struct {
+		children map[string]*patternNode
+		entries  []listenerEntry
+	}
+
+	// emitter stores every event's listeners as a plain slice behind a
+	// single RWMutex. Writers never mutate a stored slice in place — they
+	// always build and install a brand new backing array — so a slice
+	// obtained under RLock remains a safe, consistent snapshot to iterate
+	// after the lock is released, even if Add/RemoveListener runs
+	// concurrently with that iteration.
 	emitter struct {
 		maxListeners int
-		evtListeners sync.Map
-		mu           sync.Mutex
+
+		mu           sync.RWMutex
+		evtListeners map[EventName][]listenerEntry
+		onceEntries  map[EventName][]onceEntry
+		ctxListeners map[EventName][]ctxListenerEntry
+		patterns     *patternNode
+		patternNames map[EventName]struct{}
+
+		errorHandler ErrorHandler // guarded by mu
+
+		nextID uint64
+
+		dispatched       uint64 // atomic
+		panicked         uint64 // atomic
+		droppedAsyncFull uint64 // atomic
+
+		async     bool
+		eventCh   chan emitEvent
+		closed    chan struct{}
+		closeOnce sync.Once
+		workersWG sync.WaitGroup
+		pendingWG sync.WaitGroup
 	}
 )
 
 func New() EventEmitter {
-	return &emitter{maxListeners: DefaultMaxListeners}
+	return &emitter{
+		maxListeners: DefaultMaxListeners,
+		evtListeners: make(map[EventName][]listenerEntry),
+	}
+}
+
+// NewAsync returns an AsyncEventEmitter that queues emits onto a channel of
+// the given bufferSize and dispatches them using a pool of workers
+// goroutines, each running listeners for one emit at a time. Use EmitSync
+// to bypass the queue when a particular emit must run on the calling
+// goroutine.
+func NewAsync(bufferSize, workers int) AsyncEventEmitter {
+	if workers <= 0 {
+		workers = 1
+	}
+
+	e := &emitter{
+		maxListeners: DefaultMaxListeners,
+		evtListeners: make(map[EventName][]listenerEntry),
+		async:        true,
+		eventCh:      make(chan emitEvent, bufferSize),
+		closed:       make(chan struct{}),
+	}
+
+	for i := 0; i < workers; i++ {
+		e.workersWG.Add(1)
+		go e.worker()
+	}
+
+	return e
+}
+
+func (e *emitter) worker() {
+	defer e.workersWG.Done()
+	for ev := range e.eventCh {
+		e.dispatch(ev.evt, ev.ctx, ev.data)
+		e.pendingWG.Done()
+	}
 }
 
 var (
-	_              EventEmitter = &emitter{}
-	defaultEmitter EventEmitter = New()
+	_              EventEmitter           = &emitter{}
+	_              AsyncEventEmitter      = &emitter{}
+	_              ContextEventEmitter    = &emitter{}
+	_              ObservableEventEmitter = &emitter{}
+	defaultEmitter EventEmitter           = New()
 )
 
 func AddListener(evt EventName, listener ...Listener) {
@@ -54,20 +233,158 @@ func AddListener(evt EventName, listener ...Listener) {
 }
 
 func (e *emitter) AddListener(evt EventName, listeners ...Listener) {
+	for _, listener := range listeners {
+		id := atomic.AddUint64(&e.nextID, 1)
+		if isWildcardPattern(evt) {
+			e.addPatternListener(evt, id, listener)
+			continue
+		}
+		e.addListenerEntry(evt, id, listener)
+	}
+}
+
+// isWildcardPattern reports whether evt is a hierarchical, dot-separated
+// pattern containing a "*" (single segment) or "**" (any segment count)
+// wildcard, as opposed to a concrete event name.
+func isWildcardPattern(evt EventName) bool {
+	for _, segment := range strings.Split(string(evt), ".") {
+		if segment == "*" || segment == "**" {
+			return true
+		}
+	}
+	return false
+}
+
+// addPatternListener registers listener under the wildcard pattern evt in
+// the pattern trie, using the caller-supplied id. The id must be minted
+// (via atomic.AddUint64(&e.nextID, 1)) before the listener can possibly be
+// invoked, so callers that close over it for self-removal (Once) never
+// observe it at its zero value.
+func (e *emitter) addPatternListener(evt EventName, id uint64, listener Listener) uint64 {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
-	currentListenersRaw, _ := e.evtListeners.LoadOrStore(evt, []Listener{})
-	currentListeners := currentListenersRaw.([]Listener)
+	if e.patterns == nil {
+		e.patterns = &patternNode{}
+	}
+	if e.patternNames == nil {
+		e.patternNames = make(map[EventName]struct{})
+	}
 
-	if e.maxListeners > 0 && len(currentListeners) >= e.maxListeners {
-		if EnableWarning {
-			log.Printf(`(events) warning: possible EventEmitter memory leak detected. %d listeners added. Use emitter.SetMaxListeners(n int) to increase limit.`, len(currentListeners))
+	node := e.patterns
+	for _, segment := range strings.Split(string(evt), ".") {
+		if node.children == nil {
+			node.children = make(map[string]*patternNode)
+		}
+		child, ok := node.children[segment]
+		if !ok {
+			child = &patternNode{}
+			node.children[segment] = child
 		}
+		node = child
+	}
+
+	node.entries = cloneAppendListeners(node.entries, listenerEntry{id: id, fn: listener})
+	e.patternNames[evt] = struct{}{}
+	return id
+}
+
+// matchPatterns returns every listener subscribed under a wildcard pattern
+// that matches evt.
+func (e *emitter) matchPatterns(evt EventName) []listenerEntry {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	if e.patterns == nil {
+		return nil
+	}
+
+	var matched []listenerEntry
+	matchPatternNode(e.patterns, strings.Split(string(evt), "."), &matched)
+	return matched
+}
+
+func matchPatternNode(node *patternNode, segments []string, matched *[]listenerEntry) {
+	if node == nil {
+		return
+	}
+
+	if catchAll, ok := node.children["**"]; ok {
+		*matched = append(*matched, catchAll.entries...)
+	}
+
+	if len(segments) == 0 {
+		*matched = append(*matched, node.entries...)
 		return
 	}
 
-	e.evtListeners.Store(evt, append(currentListeners, listeners...))
+	head, rest := segments[0], segments[1:]
+	if child, ok := node.children[head]; ok {
+		matchPatternNode(child, rest, matched)
+	}
+	if child, ok := node.children["*"]; ok {
+		matchPatternNode(child, rest, matched)
+	}
+}
+
+// PatternNames returns the wildcard patterns currently subscribed via On
+// or Once. A pattern is removed from this list once its last listener is
+// dropped via RemoveListener, RemoveAllListeners, or a fired Once.
+func PatternNames() []EventName {
+	return defaultEmitter.PatternNames()
+}
+
+func (e *emitter) PatternNames() []EventName {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	names := make([]EventName, 0, len(e.patternNames))
+	for name := range e.patternNames {
+		names = append(names, name)
+	}
+	return names
+}
+
+// cloneAppendListeners returns a freshly allocated slice containing
+// entries followed by extra. It never reuses entries' backing array, so a
+// reader holding an older reference to entries is unaffected.
+func cloneAppendListeners(entries []listenerEntry, extra ...listenerEntry) []listenerEntry {
+	out := make([]listenerEntry, len(entries)+len(extra))
+	copy(out, entries)
+	copy(out[len(entries):], extra)
+	return out
+}
+
+// cloneWithoutListener returns a freshly allocated copy of entries with the
+// element at idx removed, again without aliasing entries' backing array.
+func cloneWithoutListener(entries []listenerEntry, idx int) []listenerEntry {
+	out := make([]listenerEntry, 0, len(entries)-1)
+	out = append(out, entries[:idx]...)
+	out = append(out, entries[idx+1:]...)
+	return out
+}
+
+// addListenerEntry registers a single listener under the caller-supplied
+// id and returns it, or 0 if the listener was rejected because
+// maxListeners was reached. The id must be minted (via
+// atomic.AddUint64(&e.nextID, 1)) before the listener can possibly be
+// invoked, so callers that close over it for self-removal (Once) never
+// observe it at its zero value.
+func (e *emitter) addListenerEntry(evt EventName, id uint64, listener Listener) uint64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	current := e.evtListeners[evt]
+
+	if e.maxListeners > 0 && len(current) >= e.maxListeners {
+		if EnableWarning {
+			log.Printf(`(events) warning: possible EventEmitter memory leak detected. %d listeners added. Use emitter.SetMaxListeners(n int) to increase limit.`, len(current))
+		}
+		return 0
+	}
+
+	e.evtListeners[evt] = cloneAppendListeners(current, listenerEntry{id: id, fn: listener})
+	return id
 }
 
 func Emit(evt EventName, data ...interface{}) {
@@ -75,12 +392,234 @@ func Emit(evt EventName, data ...interface{}) {
 }
 
 func (e *emitter) Emit(evt EventName, data ...interface{}) {
-	if listenersRaw, ok := e.evtListeners.Load(evt); ok {
-		listeners := listenersRaw.([]Listener)
-		for _, listener := range listeners {
-			listener(data...)
+	e.emit(evt, context.Background(), data)
+}
+
+// OnCtx registers a context-aware listener for evt. Each invocation
+// receives the context passed to the triggering EmitCtx call (or
+// context.Background() if the event was raised via Emit).
+func OnCtx(evt EventName, listener ListenerCtx) {
+	defaultEmitter.(ContextEventEmitter).OnCtx(evt, listener)
+}
+
+func (e *emitter) OnCtx(evt EventName, listener ListenerCtx) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.ctxListeners == nil {
+		e.ctxListeners = make(map[EventName][]ctxListenerEntry)
+	}
+	current := e.ctxListeners[evt]
+
+	if e.maxListeners > 0 && len(current) >= e.maxListeners {
+		if EnableWarning {
+			log.Printf(`(events) warning: possible EventEmitter memory leak detected. %d listeners added. Use emitter.SetMaxListeners(n int) to increase limit.`, len(current))
+		}
+		return
+	}
+
+	out := make([]ctxListenerEntry, len(current)+1)
+	copy(out, current)
+	out[len(current)] = ctxListenerEntry{id: atomic.AddUint64(&e.nextID, 1), fn: listener}
+	e.ctxListeners[evt] = out
+}
+
+// EmitCtx emits evt with ctx available to every listener registered via
+// OnCtx. If ctx is already cancelled when the emit is dispatched (at the
+// call on a synchronous emitter, or when a worker picks the emit up on an
+// async one), the whole invocation is skipped: no listener, ctx-aware or
+// not, runs for it.
+func EmitCtx(ctx context.Context, evt EventName, data ...interface{}) {
+	defaultEmitter.(ContextEventEmitter).EmitCtx(ctx, evt, data...)
+}
+
+func (e *emitter) EmitCtx(ctx context.Context, evt EventName, data ...interface{}) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	e.emit(evt, ctx, data)
+}
+
+func (e *emitter) emit(evt EventName, ctx context.Context, data []interface{}) {
+	if !e.async {
+		e.dispatch(evt, ctx, data)
+		return
+	}
+
+	select {
+	case <-e.closed:
+		return
+	default:
+	}
+
+	e.pendingWG.Add(1)
+	if !e.trySend(emitEvent{evt: evt, ctx: ctx, data: data}) {
+		e.pendingWG.Done()
+		atomic.AddUint64(&e.droppedAsyncFull, 1)
+	}
+}
+
+// trySend queues ev onto eventCh, returning false if the queue is full or
+// the channel was closed by a concurrent Close between emit's closed
+// check above and this send. That race is real: this package's own
+// handlePanic re-emits on ErrorEvent from a worker goroutine, so a Close
+// call can land in the same narrow window. recover() turns the resulting
+// "send on closed channel" panic into an ordinary drop instead of taking
+// down the caller.
+func (e *emitter) trySend(ev emitEvent) (sent bool) {
+	defer func() {
+		if recover() != nil {
+			sent = false
+		}
+	}()
+
+	select {
+	case e.eventCh <- ev:
+		return true
+	default:
+		return false
+	}
+}
+
+// EmitSync calls evt's listeners on the calling goroutine, bypassing the
+// worker queue even if the emitter is async.
+func (e *emitter) EmitSync(evt EventName, data ...interface{}) {
+	e.dispatch(evt, context.Background(), data)
+}
+
+// dispatch snapshots each relevant listener slice under a read lock, then
+// invokes the snapshot after releasing the lock. Because every write path
+// replaces a slice wholesale instead of mutating it, the snapshot stays
+// valid even if listeners are added or removed mid-dispatch; such changes
+// simply won't be visible to this pass.
+//
+// If ctx is already cancelled by the time this runs (because EmitCtx was
+// called with an already-cancelled ctx, or, in async mode, because a
+// worker picked up a queued EmitCtx after its deadline/cancellation
+// fired), the whole invocation is skipped: no evtListeners, pattern
+// listeners, or ctxListeners run for it.
+func (e *emitter) dispatch(evt EventName, ctx context.Context, data []interface{}) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	e.mu.RLock()
+	entries := e.evtListeners[evt]
+	e.mu.RUnlock()
+
+	for _, entry := range entries {
+		fn := entry.fn
+		e.invoke(evt, func() { fn(data...) })
+	}
+
+	for _, entry := range e.matchPatterns(evt) {
+		fn := entry.fn
+		e.invoke(evt, func() { fn(data...) })
+	}
+
+	e.mu.RLock()
+	ctxEntries := e.ctxListeners[evt]
+	e.mu.RUnlock()
+
+	for _, entry := range ctxEntries {
+		fn := entry.fn
+		e.invoke(evt, func() { fn(ctx, data...) })
+	}
+}
+
+// invoke runs a single listener call with panic isolation: a recovered
+// panic never propagates past this call, so it can't abort the rest of
+// dispatch or take down the caller of Emit.
+func (e *emitter) invoke(evt EventName, call func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			atomic.AddUint64(&e.panicked, 1)
+			e.handlePanic(evt, r)
 		}
+	}()
+
+	call()
+	atomic.AddUint64(&e.dispatched, 1)
+}
+
+// handlePanic routes a recovered listener panic to the configured
+// ErrorHandler, or failing that re-emits it on ErrorEvent with the
+// offending EventName, mirroring Node.js's "error" event convention.
+func (e *emitter) handlePanic(evt EventName, recovered interface{}) {
+	e.mu.RLock()
+	handler := e.errorHandler
+	e.mu.RUnlock()
+
+	if handler != nil {
+		handler(evt, recovered)
+		return
 	}
+
+	if evt == ErrorEvent {
+		// A listener on the reserved error event itself panicked; don't
+		// re-emit ErrorEvent again, or a listener that always panics
+		// would recurse forever.
+		if EnableWarning {
+			log.Printf("(events) warning: listener for reserved %q event panicked: %v", ErrorEvent, recovered)
+		}
+		return
+	}
+
+	e.Emit(ErrorEvent, evt, recovered)
+}
+
+// SetErrorHandler installs handler to receive recovered listener panics
+// instead of them being re-emitted on ErrorEvent.
+func SetErrorHandler(handler ErrorHandler) {
+	defaultEmitter.(ObservableEventEmitter).SetErrorHandler(handler)
+}
+
+func (e *emitter) SetErrorHandler(handler ErrorHandler) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.errorHandler = handler
+}
+
+// GetStats returns the default emitter's cumulative dispatch counters.
+func GetStats() Stats {
+	return defaultEmitter.(ObservableEventEmitter).Stats()
+}
+
+func (e *emitter) Stats() Stats {
+	return Stats{
+		Dispatched:       atomic.LoadUint64(&e.dispatched),
+		Panicked:         atomic.LoadUint64(&e.panicked),
+		DroppedAsyncFull: atomic.LoadUint64(&e.droppedAsyncFull),
+	}
+}
+
+// Close stops accepting new emits and waits for the worker pool to finish
+// draining and exit. It is a no-op on a synchronous emitter. A goroutine
+// that calls Emit concurrently with Close may have its emit silently
+// dropped instead of delivered, but will never panic. Such a drop is
+// counted in Stats().DroppedAsyncFull only if the emit passed the closed
+// check before Close closed eventCh; an emit that sees the emitter already
+// closed is dropped without being counted.
+func (e *emitter) Close() error {
+	if !e.async {
+		return nil
+	}
+	e.closeOnce.Do(func() {
+		close(e.closed)
+		close(e.eventCh)
+	})
+	e.workersWG.Wait()
+	return nil
+}
+
+// Drain blocks until every emit queued so far has been dispatched. It is a
+// no-op on a synchronous emitter.
+func (e *emitter) Drain() {
+	if !e.async {
+		return
+	}
+	e.pendingWG.Wait()
 }
 
 func EventNames() []EventName {
@@ -88,11 +627,13 @@ func EventNames() []EventName {
 }
 
 func (e *emitter) EventNames() []EventName {
-	var names []EventName
-	e.evtListeners.Range(func(key, value interface{}) bool {
-		names = append(names, key.(EventName))
-		return true
-	})
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	names := make([]EventName, 0, len(e.evtListeners))
+	for name := range e.evtListeners {
+		names = append(names, name)
+	}
 	return names
 }
 
@@ -109,11 +650,17 @@ func ListenerCount(evt EventName) int {
 }
 
 func (e *emitter) ListenerCount(evt EventName) int {
-	if listenersRaw, ok := e.evtListeners.Load(evt); ok {
-		listeners := listenersRaw.([]Listener)
-		return len(listeners)
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	if isWildcardPattern(evt) {
+		if node := e.findPatternNode(evt); node != nil {
+			return len(node.entries)
+		}
+		return 0
 	}
-	return 0
+
+	return len(e.evtListeners[evt])
 }
 
 func Listeners(evt EventName) []Listener {
@@ -121,11 +668,25 @@ func Listeners(evt EventName) []Listener {
 }
 
 func (e *emitter) Listeners(evt EventName) []Listener {
-	if listenersRaw, ok := e.evtListeners.Load(evt); ok {
-		listeners := listenersRaw.([]Listener)
-		return listeners
+	e.mu.RLock()
+	var entries []listenerEntry
+	if isWildcardPattern(evt) {
+		if node := e.findPatternNode(evt); node != nil {
+			entries = node.entries
+		}
+	} else {
+		entries = e.evtListeners[evt]
 	}
-	return nil
+	e.mu.RUnlock()
+
+	if entries == nil {
+		return nil
+	}
+	listeners := make([]Listener, len(entries))
+	for i, entry := range entries {
+		listeners[i] = entry.fn
+	}
+	return listeners
 }
 
 func On(evt EventName, listener ...Listener) {
@@ -140,8 +701,168 @@ func Once(evt EventName, listener ...Listener) {
 	defaultEmitter.Once(evt, listener...)
 }
 
+// Once registers listeners that fire at most one time: after their first
+// invocation each is removed from evt, mirroring Node.js EventEmitter's
+// Once contract. The original function pointer remains valid for
+// RemoveListener, which is resolved against a per-event record of which
+// wrapper it was installed as.
 func (e *emitter) Once(evt EventName, listeners ...Listener) {
-	e.AddListener(evt, listeners...)
+	for _, listener := range listeners {
+		e.addOnceListener(evt, listener)
+	}
+}
+
+func (e *emitter) addOnceListener(evt EventName, listener Listener) {
+	var once sync.Once
+
+	// id must be minted before wrapper is installed: wrapper closes over
+	// it, and once installed it can be invoked by a concurrent Emit before
+	// this function continues, so assigning id afterwards would be a data
+	// race that leaves self-removal reading the zero value.
+	id := atomic.AddUint64(&e.nextID, 1)
+
+	wrapper := func(data ...interface{}) {
+		once.Do(func() {
+			e.removeListenerByID(evt, id)
+			e.forgetOnceEntry(evt, id)
+		})
+		listener(data...)
+	}
+
+	if isWildcardPattern(evt) {
+		e.addPatternListener(evt, id, wrapper)
+	} else {
+		if e.addListenerEntry(evt, id, wrapper) == 0 {
+			return
+		}
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.onceEntries == nil {
+		e.onceEntries = make(map[EventName][]onceEntry)
+	}
+	current := e.onceEntries[evt]
+	out := make([]onceEntry, len(current)+1)
+	copy(out, current)
+	out[len(current)] = onceEntry{
+		original: reflect.ValueOf(listener).Pointer(),
+		id:       id,
+	}
+	e.onceEntries[evt] = out
+}
+
+// removeEntryByID removes the listenerEntry with the given id from evt's
+// slice, if present.
+func (e *emitter) removeEntryByID(evt EventName, id uint64) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	entries, ok := e.evtListeners[evt]
+	if !ok {
+		return false
+	}
+	for i, entry := range entries {
+		if entry.id == id {
+			e.evtListeners[evt] = cloneWithoutListener(entries, i)
+			return true
+		}
+	}
+	return false
+}
+
+// removeListenerByID removes the listener installed under id for evt,
+// routing to the pattern trie or the concrete-name map depending on
+// whether evt is a wildcard pattern. It is the id-based counterpart used
+// by Once's self-removing wrapper, which must work the same way
+// regardless of which storage the original registration went through.
+func (e *emitter) removeListenerByID(evt EventName, id uint64) bool {
+	if isWildcardPattern(evt) {
+		return e.removePatternEntryByID(evt, id)
+	}
+	return e.removeEntryByID(evt, id)
+}
+
+// findPatternNode walks the pattern trie to the node registered for the
+// exact pattern evt, returning nil if nothing was ever registered under
+// it. Callers must hold e.mu.
+func (e *emitter) findPatternNode(evt EventName) *patternNode {
+	node := e.patterns
+	for _, segment := range strings.Split(string(evt), ".") {
+		if node == nil {
+			return nil
+		}
+		node = node.children[segment]
+	}
+	return node
+}
+
+// removePatternEntryByID removes the pattern-trie entry with the given id
+// registered under the exact pattern evt, if present.
+func (e *emitter) removePatternEntryByID(evt EventName, id uint64) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	node := e.findPatternNode(evt)
+	if node == nil {
+		return false
+	}
+	for i, entry := range node.entries {
+		if entry.id == id {
+			node.entries = cloneWithoutListener(node.entries, i)
+			if len(node.entries) == 0 {
+				delete(e.patternNames, evt)
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// removePatternListener removes the pattern-trie entry registered under
+// the exact pattern evt whose fn matches listenerPtr, returning the id it
+// was installed under so the caller can also drop any onceEntry
+// bookkeeping for it.
+func (e *emitter) removePatternListener(evt EventName, listenerPtr uintptr) (removed bool, id uint64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	node := e.findPatternNode(evt)
+	if node == nil {
+		return false, 0
+	}
+	for i, entry := range node.entries {
+		if reflect.ValueOf(entry.fn).Pointer() == listenerPtr {
+			node.entries = cloneWithoutListener(node.entries, i)
+			if len(node.entries) == 0 {
+				delete(e.patternNames, evt)
+			}
+			return true, entry.id
+		}
+	}
+	return false, 0
+}
+
+// forgetOnceEntry drops the onceEntry bookkeeping for the given id once its
+// wrapper has fired or has been removed directly.
+func (e *emitter) forgetOnceEntry(evt EventName, id uint64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	onceEntries, ok := e.onceEntries[evt]
+	if !ok {
+		return
+	}
+	for i, oe := range onceEntries {
+		if oe.id == id {
+			out := make([]onceEntry, 0, len(onceEntries)-1)
+			out = append(out, onceEntries[:i]...)
+			out = append(out, onceEntries[i+1:]...)
+			e.onceEntries[evt] = out
+			return
+		}
+	}
 }
 
 func RemoveAllListeners(evt EventName) bool {
@@ -152,8 +873,18 @@ func (e *emitter) RemoveAllListeners(evt EventName) bool {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
-	e.evtListeners.Delete(evt)
-	_, found := e.evtListeners.Load(evt)
+	if isWildcardPattern(evt) {
+		if node := e.findPatternNode(evt); node != nil {
+			node.entries = nil
+		}
+		delete(e.patternNames, evt)
+		return true
+	}
+
+	delete(e.evtListeners, evt)
+	delete(e.onceEntries, evt)
+	delete(e.ctxListeners, evt)
+	_, found := e.evtListeners[evt]
 	return !found
 }
 
@@ -162,21 +893,53 @@ func RemoveListener(evt EventName, listener Listener) bool {
 }
 
 func (e *emitter) RemoveListener(evt EventName, listener Listener) bool {
-	e.mu.Lock()
-	defer e.mu.Unlock()
+	listenerPtr := reflect.ValueOf(listener).Pointer()
 
-	if listenersRaw, ok := e.evtListeners.Load(evt); ok {
-		listeners := listenersRaw.([]Listener)
-		for i, l := range listeners {
-			if reflect.ValueOf(l).Pointer() == reflect.ValueOf(listener).Pointer() {
-				e.evtListeners.Store(evt, append(listeners[:i], listeners[i+1:]...))
-				return true
+	if isWildcardPattern(evt) {
+		if removed, id := e.removePatternListener(evt, listenerPtr); removed {
+			e.forgetOnceEntry(evt, id)
+			return true
+		}
+	} else {
+		e.mu.Lock()
+		if entries, ok := e.evtListeners[evt]; ok {
+			for i, entry := range entries {
+				if reflect.ValueOf(entry.fn).Pointer() == listenerPtr {
+					e.evtListeners[evt] = cloneWithoutListener(entries, i)
+					removedID := entry.id
+					e.mu.Unlock()
+					e.forgetOnceEntry(evt, removedID)
+					return true
+				}
 			}
 		}
+		e.mu.Unlock()
+	}
+
+	// listener didn't match directly; it may be the original function
+	// passed to Once, whose actual entry is a wrapper installed under a
+	// different id, in either the concrete-name map or the pattern trie.
+	if id, ok := e.lookupOnceID(evt, listenerPtr); ok {
+		removed := e.removeListenerByID(evt, id)
+		e.forgetOnceEntry(evt, id)
+		return removed
 	}
+
 	return false
 }
 
+func (e *emitter) lookupOnceID(evt EventName, original uintptr) (uint64, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	for _, oe := range e.onceEntries[evt] {
+		if oe.original == original {
+			return oe.id, true
+		}
+	}
+	return 0, false
+}
+
 func Clear() {
 	defaultEmitter.Clear()
 }
@@ -185,7 +948,11 @@ func (e *emitter) Clear() {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
-	e.evtListeners = sync.Map{}
+	e.evtListeners = make(map[EventName][]listenerEntry)
+	e.onceEntries = make(map[EventName][]onceEntry)
+	e.ctxListeners = make(map[EventName][]ctxListenerEntry)
+	e.patterns = nil
+	e.patternNames = nil
 }
 
 func SetMaxListeners(n int) {
@@ -207,10 +974,8 @@ func Len() int {
 }
 
 func (e *emitter) Len() int {
-	length := 0
-	e.evtListeners.Range(func(key, value interface{}) bool {
-		length++
-		return true
-	})
-	return length
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return len(e.evtListeners)
 }
@@ -0,0 +1,94 @@
+package events
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// TestConcurrentEmitAddRemoveListener exercises the claim behind the
+// copy-on-write listener storage: Emit snapshots evtListeners under RLock
+// before invoking anything, so listeners being added or removed on other
+// goroutines can never race with an in-flight dispatch's iteration. Run
+// with -race to catch any regression back to in-place slice mutation.
+func TestConcurrentEmitAddRemoveListener(t *testing.T) {
+	e := New()
+
+	const iterations = 200
+
+	var wg sync.WaitGroup
+	wg.Add(3)
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < iterations; i++ {
+			e.Emit("evt", i)
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < iterations; i++ {
+			fn := func(data ...interface{}) {}
+			e.AddListener("evt", fn)
+			e.RemoveListener("evt", fn)
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < iterations; i++ {
+			e.ListenerCount("evt")
+			e.Listeners("evt")
+		}
+	}()
+
+	wg.Wait()
+}
+
+// TestConcurrentOnceFiresExactlyOnce guards against a regression where
+// addOnceListener's id was assigned only after the wrapper was already
+// installed: a concurrent Emit could invoke the freshly registered wrapper (which
+// closes over id for self-removal) while it still read as its zero value,
+// so removeListenerByID(evt, 0) never found the entry and the listener
+// could run more than once under concurrent registration and emission.
+// Run with -race to confirm id is never read and written concurrently.
+func TestConcurrentOnceFiresExactlyOnce(t *testing.T) {
+	e := New()
+
+	const listeners = 200
+
+	var fired uint64
+	var wg sync.WaitGroup
+	wg.Add(listeners + 1)
+
+	for i := 0; i < listeners; i++ {
+		go func() {
+			defer wg.Done()
+			e.Once("evt", func(data ...interface{}) {
+				atomic.AddUint64(&fired, 1)
+			})
+		}()
+	}
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < listeners*3; i++ {
+			e.Emit("evt")
+		}
+	}()
+
+	wg.Wait()
+
+	// Every one of the listeners registered above must have fired exactly
+	// once, however interleaved their registration was with the emits.
+	deadline := listeners * 3
+	for atomic.LoadUint64(&fired) < uint64(listeners) && deadline > 0 {
+		e.Emit("evt")
+		deadline--
+	}
+
+	if got := atomic.LoadUint64(&fired); got != uint64(listeners) {
+		t.Fatalf("fired = %d, want exactly %d (one per Once registration)", got, listeners)
+	}
+}
@@ -0,0 +1,83 @@
+package events
+
+import "testing"
+
+// TestOnceFiresExactlyOnce asserts the core Once contract: the listener
+// runs on the first Emit after registration and never again, regardless
+// of how many further Emits follow.
+func TestOnceFiresExactlyOnce(t *testing.T) {
+	e := New()
+
+	count := 0
+	e.Once("greet", func(data ...interface{}) {
+		count++
+	})
+
+	e.Emit("greet")
+	e.Emit("greet")
+	e.Emit("greet")
+
+	if count != 1 {
+		t.Fatalf("listener fired %d times, want exactly 1", count)
+	}
+	if n := e.ListenerCount("greet"); n != 0 {
+		t.Fatalf("ListenerCount(%q) after firing = %d, want 0", "greet", n)
+	}
+}
+
+// TestOnceWildcardFiresOnce guards against a regression where Once on a
+// wildcard pattern was stored in the concrete-name map instead of the
+// pattern trie, so the listener never fired and the pattern never showed
+// up in PatternNames.
+func TestOnceWildcardFiresOnce(t *testing.T) {
+	e := New()
+
+	count := 0
+	e.Once("user.*", func(data ...interface{}) {
+		count++
+	})
+
+	if n := e.ListenerCount("user.*"); n != 1 {
+		t.Fatalf("ListenerCount(%q) = %d, want 1", "user.*", n)
+	}
+	names := e.PatternNames()
+	if len(names) != 1 || names[0] != "user.*" {
+		t.Fatalf("PatternNames() = %v, want [user.*]", names)
+	}
+
+	e.Emit("user.created")
+	e.Emit("user.created")
+
+	if count != 1 {
+		t.Fatalf("listener fired %d times, want exactly 1", count)
+	}
+	if n := e.ListenerCount("user.*"); n != 0 {
+		t.Fatalf("ListenerCount(%q) after firing = %d, want 0", "user.*", n)
+	}
+	if names := e.PatternNames(); len(names) != 0 {
+		t.Fatalf("PatternNames() after firing = %v, want empty", names)
+	}
+}
+
+// TestRemoveListenerWildcard confirms a wildcard subscription registered
+// via On can be unsubscribed again, unlike the original implementation
+// where e.patterns/e.patternNames were only ever reset by Clear.
+func TestRemoveListenerWildcard(t *testing.T) {
+	e := New()
+
+	fn := func(data ...interface{}) {}
+	e.On("order.*", fn)
+
+	if n := e.ListenerCount("order.*"); n != 1 {
+		t.Fatalf("ListenerCount(%q) = %d, want 1", "order.*", n)
+	}
+	if !e.RemoveListener("order.*", fn) {
+		t.Fatalf("RemoveListener(%q) = false, want true", "order.*")
+	}
+	if n := e.ListenerCount("order.*"); n != 0 {
+		t.Fatalf("ListenerCount(%q) after removal = %d, want 0", "order.*", n)
+	}
+	if names := e.PatternNames(); len(names) != 0 {
+		t.Fatalf("PatternNames() after removal = %v, want empty", names)
+	}
+}
@@ -0,0 +1,99 @@
+package events
+
+import "testing"
+
+// TestPanicIsolation confirms a panicking listener doesn't abort the
+// other listeners registered for the same event, and is reflected in
+// Stats().
+func TestPanicIsolation(t *testing.T) {
+	e := New().(ObservableEventEmitter)
+
+	var before, after bool
+	e.On("evt", func(data ...interface{}) { before = true })
+	e.On("evt", func(data ...interface{}) { panic("boom") })
+	e.On("evt", func(data ...interface{}) { after = true })
+
+	e.Emit("evt")
+
+	if !before || !after {
+		t.Fatalf("before=%v after=%v, want both true: panicking listener aborted the rest", before, after)
+	}
+
+	stats := e.Stats()
+	if stats.Dispatched != 2 {
+		t.Fatalf("Stats().Dispatched = %d, want 2", stats.Dispatched)
+	}
+	if stats.Panicked != 1 {
+		t.Fatalf("Stats().Panicked = %d, want 1", stats.Panicked)
+	}
+}
+
+// TestPanicReEmitsOnErrorEvent confirms a recovered panic is re-emitted on
+// ErrorEvent, carrying the offending EventName and panic value, when no
+// ErrorHandler is set.
+func TestPanicReEmitsOnErrorEvent(t *testing.T) {
+	e := New().(ObservableEventEmitter)
+
+	var gotEvt EventName
+	var gotErr interface{}
+	e.On(ErrorEvent, func(data ...interface{}) {
+		gotEvt, _ = data[0].(EventName)
+		gotErr = data[1]
+	})
+	e.On("evt", func(data ...interface{}) { panic("boom") })
+
+	e.Emit("evt")
+
+	if gotEvt != "evt" {
+		t.Fatalf("ErrorEvent listener saw EventName %q, want %q", gotEvt, "evt")
+	}
+	if gotErr != "boom" {
+		t.Fatalf("ErrorEvent listener saw panic value %v, want %q", gotErr, "boom")
+	}
+}
+
+// TestPanicRoutedToErrorHandler confirms SetErrorHandler intercepts
+// recovered panics instead of them being re-emitted on ErrorEvent.
+func TestPanicRoutedToErrorHandler(t *testing.T) {
+	e := New().(ObservableEventEmitter)
+
+	var gotEvt EventName
+	var gotErr interface{}
+	e.SetErrorHandler(func(evt EventName, recovered interface{}) {
+		gotEvt = evt
+		gotErr = recovered
+	})
+
+	errorEventFired := false
+	e.On(ErrorEvent, func(data ...interface{}) { errorEventFired = true })
+	e.On("evt", func(data ...interface{}) { panic("boom") })
+
+	e.Emit("evt")
+
+	if gotEvt != "evt" || gotErr != "boom" {
+		t.Fatalf("ErrorHandler saw (%q, %v), want (%q, %q)", gotEvt, gotErr, "evt", "boom")
+	}
+	if errorEventFired {
+		t.Fatal("ErrorEvent listener fired even though an ErrorHandler was set")
+	}
+}
+
+// TestPanicOnErrorEventDoesNotRecurse confirms a listener on the reserved
+// ErrorEvent that itself panics doesn't recurse forever re-emitting
+// ErrorEvent.
+func TestPanicOnErrorEventDoesNotRecurse(t *testing.T) {
+	e := New().(ObservableEventEmitter)
+
+	calls := 0
+	e.On(ErrorEvent, func(data ...interface{}) {
+		calls++
+		panic("error handler itself panics")
+	})
+	e.On("evt", func(data ...interface{}) { panic("boom") })
+
+	e.Emit("evt")
+
+	if calls != 1 {
+		t.Fatalf("ErrorEvent listener ran %d times, want exactly 1 (no recursion)", calls)
+	}
+}
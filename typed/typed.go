@@ -0,0 +1,169 @@
+// Package typed provides a generic, compile-time-typed wrapper around
+// events.EventEmitter. It exists alongside the untyped package (rather than
+// replacing it) so that call sites can drop the `...interface{}` /
+// type-assertion boilerplate the base API requires, without breaking any
+// existing user of events.EventEmitter.
+package typed
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	events "github.com/erock530/go.events"
+)
+
+// TypedEmitter is an EventEmitter specialized to a single payload type T.
+// Internally it delegates to an events.EventEmitter, boxing/unboxing T at
+// the boundary so user code never sees interface{}.
+type TypedEmitter[T any] struct {
+	emitter events.EventEmitter
+
+	nextID uint64
+
+	mu      sync.Mutex
+	entries map[events.EventName][]typedEntry[T]
+}
+
+// typedEntry tracks which wrapped events.Listener a func(T) was registered
+// as, so RemoveListener can be resolved against the original function
+// pointer even though the emitter only ever sees the wrapper. id is a
+// monotonic identifier independent of the wrapper's code pointer, which a
+// Once-fired wrapper uses to drop its own entry without racing a
+// concurrent RemoveListener call for the same original function.
+type typedEntry[T any] struct {
+	id       uint64
+	original uintptr
+	wrapped  events.Listener
+}
+
+// NewTyped creates a TypedEmitter for payload type T.
+func NewTyped[T any]() *TypedEmitter[T] {
+	return &TypedEmitter[T]{
+		emitter: events.New(),
+		entries: make(map[events.EventName][]typedEntry[T]),
+	}
+}
+
+func (e *TypedEmitter[T]) wrap(listener func(T)) events.Listener {
+	return func(data ...interface{}) {
+		if len(data) == 0 {
+			var zero T
+			listener(zero)
+			return
+		}
+		listener(data[0].(T))
+	}
+}
+
+// wrapOnce is like wrap, but also drops its own bookkeeping entry after
+// the listener fires, since the base emitter's Once contract guarantees
+// this wrapper is invoked at most once. Without this, entries would keep
+// a stale typedEntry for every Once call whose listener fired, since
+// RemoveListener (through untrackByOriginal) is otherwise the only path
+// that drops one.
+func (e *TypedEmitter[T]) wrapOnce(evt events.EventName, id uint64, listener func(T)) events.Listener {
+	return func(data ...interface{}) {
+		defer e.untrackByID(evt, id)
+		if len(data) == 0 {
+			var zero T
+			listener(zero)
+			return
+		}
+		listener(data[0].(T))
+	}
+}
+
+func (e *TypedEmitter[T]) track(evt events.EventName, id uint64, original func(T), wrapped events.Listener) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.entries[evt] = append(e.entries[evt], typedEntry[T]{
+		id:       id,
+		original: reflect.ValueOf(original).Pointer(),
+		wrapped:  wrapped,
+	})
+}
+
+// untrackByID drops the entry registered under id, if still present. It is
+// called only by wrapOnce, once its listener has fired; RemoveListener
+// drops entries through untrackByOriginal instead.
+func (e *TypedEmitter[T]) untrackByID(evt events.EventName, id uint64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	entries := e.entries[evt]
+	for i, entry := range entries {
+		if entry.id == id {
+			e.entries[evt] = append(entries[:i], entries[i+1:]...)
+			return
+		}
+	}
+}
+
+func (e *TypedEmitter[T]) untrackByOriginal(evt events.EventName, original func(T)) (events.Listener, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	ptr := reflect.ValueOf(original).Pointer()
+	entries := e.entries[evt]
+	for i, entry := range entries {
+		if entry.original == ptr {
+			e.entries[evt] = append(entries[:i], entries[i+1:]...)
+			return entry.wrapped, true
+		}
+	}
+	return nil, false
+}
+
+// On registers listener to be called every time evt is emitted.
+func (e *TypedEmitter[T]) On(evt events.EventName, listener func(T)) {
+	id := atomic.AddUint64(&e.nextID, 1)
+	wrapped := e.wrap(listener)
+	e.track(evt, id, listener, wrapped)
+	e.emitter.On(evt, wrapped)
+}
+
+// Once registers listener to be called at most once for evt. Its
+// bookkeeping entry is dropped as soon as the listener fires, so repeated
+// Once calls on a long-running emitter don't leak one entry per call.
+func (e *TypedEmitter[T]) Once(evt events.EventName, listener func(T)) {
+	id := atomic.AddUint64(&e.nextID, 1)
+	wrapped := e.wrapOnce(evt, id, listener)
+	e.track(evt, id, listener, wrapped)
+	e.emitter.Once(evt, wrapped)
+}
+
+// Emit calls every listener registered for evt with data.
+func (e *TypedEmitter[T]) Emit(evt events.EventName, data T) {
+	e.emitter.Emit(evt, data)
+}
+
+// RemoveListener removes the listener previously registered for evt via On
+// or Once, identified by the original function passed to them.
+func (e *TypedEmitter[T]) RemoveListener(evt events.EventName, listener func(T)) bool {
+	wrapped, ok := e.untrackByOriginal(evt, listener)
+	if !ok {
+		return false
+	}
+	return e.emitter.RemoveListener(evt, wrapped)
+}
+
+// EventNames returns the names of events that currently have listeners.
+func (e *TypedEmitter[T]) EventNames() []events.EventName {
+	return e.emitter.EventNames()
+}
+
+// ListenerCount returns the number of listeners registered for evt.
+func (e *TypedEmitter[T]) ListenerCount(evt events.EventName) int {
+	return e.emitter.ListenerCount(evt)
+}
+
+// RemoveAllListeners removes every listener registered for evt.
+func (e *TypedEmitter[T]) RemoveAllListeners(evt events.EventName) bool {
+	e.mu.Lock()
+	delete(e.entries, evt)
+	e.mu.Unlock()
+
+	return e.emitter.RemoveAllListeners(evt)
+}
@@ -0,0 +1,37 @@
+package typed
+
+import "testing"
+
+// TestOnceNoLeak guards against a regression where TypedEmitter.Once's
+// tracked typedEntry was only ever removed by RemoveListener, so it stayed
+// in e.entries forever once the base emitter's once-listener self-fired
+// and removed itself.
+func TestOnceNoLeak(t *testing.T) {
+	e := NewTyped[int]()
+
+	fired := 0
+	e.Once("evt", func(v int) {
+		fired++
+	})
+
+	e.mu.Lock()
+	before := len(e.entries["evt"])
+	e.mu.Unlock()
+	if before != 1 {
+		t.Fatalf("entries before firing = %d, want 1", before)
+	}
+
+	e.Emit("evt", 1)
+	e.Emit("evt", 2)
+
+	if fired != 1 {
+		t.Fatalf("listener fired %d times, want exactly 1", fired)
+	}
+
+	e.mu.Lock()
+	after := len(e.entries["evt"])
+	e.mu.Unlock()
+	if after != 0 {
+		t.Fatalf("entries after firing = %d, want 0 (bookkeeping leaked)", after)
+	}
+}